@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CollectionSpec describes one user-defined collection: its name, how many
+// pages it spans, and which background style those pages use.
+type CollectionSpec struct {
+	Name  string `yaml:"name"`
+	Pages int    `yaml:"pages"`
+	Style string `yaml:"style"` // "dotgrid", "lined", "blank", "grid", "checklist"
+}
+
+// configFile is the on-disk shape of a -config planner.yaml file.
+type configFile struct {
+	Collections []CollectionSpec `yaml:"collections"`
+}
+
+// LoadCollections reads the collections section of a planner config file.
+func LoadCollections(path string) ([]CollectionSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cf configFile
+	if err := yaml.Unmarshal(data, &cf); err != nil {
+		return nil, err
+	}
+	return cf.Collections, nil
+}
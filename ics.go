@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is a single calendar occurrence, already expanded from any RRULE.
+type Event struct {
+	Start    time.Time
+	End      time.Time
+	Summary  string
+	Location string
+}
+
+// icsTimeLayouts covers the DATE-TIME forms we accept: UTC ("Z" suffix),
+// floating/local (no suffix), and all-day DATE values.
+var icsTimeLayouts = []string{"20060102T150405Z", "20060102T150405", "20060102"}
+
+func parseICSTime(v string) (time.Time, error) {
+	for _, layout := range icsTimeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("ics: unrecognized date-time %q", v)
+}
+
+// vevent is the raw, unexpanded form of a VEVENT block.
+type vevent struct {
+	start, end        time.Time
+	summary, location string
+	rrule             map[string]string
+}
+
+// LoadICS parses the RFC 5545 calendar at path and returns every event
+// occurrence, after RRULE expansion, that falls within year.
+func LoadICS(path string, year int) ([]Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var events []Event
+	var cur *vevent
+	inEvent := false
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			cur = &vevent{}
+		case line == "END:VEVENT":
+			if cur != nil {
+				events = append(events, expandEvent(*cur, year)...)
+			}
+			inEvent = false
+			cur = nil
+		case inEvent:
+			name, value, ok := splitICSLine(line)
+			if !ok {
+				continue
+			}
+			switch name {
+			case "DTSTART":
+				if t, err := parseICSTime(value); err == nil {
+					cur.start = t
+				}
+			case "DTEND":
+				if t, err := parseICSTime(value); err == nil {
+					cur.end = t
+				}
+			case "SUMMARY":
+				cur.summary = value
+			case "LOCATION":
+				cur.location = value
+			case "RRULE":
+				cur.rrule = parseRRULE(value)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events, nil
+}
+
+// splitICSLine splits a "NAME;PARAM=X:VALUE" or "NAME:VALUE" content line,
+// discarding parameters (e.g. TZID) since icsTimeLayouts already covers the
+// UTC and floating forms the planner cares about.
+func splitICSLine(line string) (name, value string, ok bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", "", false
+	}
+	head := line[:colon]
+	value = line[colon+1:]
+	if semi := strings.Index(head, ";"); semi >= 0 {
+		head = head[:semi]
+	}
+	return head, value, true
+}
+
+func parseRRULE(value string) map[string]string {
+	out := make(map[string]string)
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) == 2 {
+			out[kv[0]] = kv[1]
+		}
+	}
+	return out
+}
+
+var byDayIndex = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// expandEvent turns a raw VEVENT into one or more Events, applying RRULE
+// (FREQ=DAILY|WEEKLY|MONTHLY|YEARLY with COUNT/UNTIL/BYDAY) and keeping only
+// occurrences that fall within year. BYDAY entries with an ordinal prefix
+// (e.g. "1MO", "-1FR") are ignored.
+func expandEvent(v vevent, year int) []Event {
+	dur := v.end.Sub(v.start)
+
+	if len(v.rrule) == 0 {
+		if v.start.Year() == year {
+			return []Event{{Start: v.start, End: v.end, Summary: v.summary, Location: v.location}}
+		}
+		return nil
+	}
+
+	freq := v.rrule["FREQ"]
+	count, hasCount := 0, false
+	if c, err := strconv.Atoi(v.rrule["COUNT"]); err == nil {
+		count, hasCount = c, true
+	}
+	until, hasUntil := time.Time{}, false
+	if u := v.rrule["UNTIL"]; u != "" {
+		if t, err := parseICSTime(u); err == nil {
+			until, hasUntil = t, true
+		}
+	}
+	var byDay []time.Weekday
+	if bd := v.rrule["BYDAY"]; bd != "" {
+		for _, d := range strings.Split(bd, ",") {
+			if wd, ok := byDayIndex[d]; ok {
+				byDay = append(byDay, wd)
+			}
+		}
+	}
+
+	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, v.start.Location())
+	yearEnd := time.Date(year+1, 1, 1, 0, 0, 0, 0, v.start.Location())
+
+	// occurrenceAt computes the i'th occurrence directly from DTSTART rather
+	// than stepping cumulatively from the previous occurrence — MONTHLY and
+	// YEARLY must re-anchor on DTSTART's day-of-month every time, or a
+	// clamped short month (e.g. Jan 31 -> Feb 28) would permanently drift
+	// the day-of-month for every occurrence after it.
+	occurrenceAt := func(i int) (t time.Time, ok bool) {
+		switch freq {
+		case "DAILY":
+			return v.start.AddDate(0, 0, i), true
+		case "WEEKLY":
+			if len(byDay) > 0 {
+				// Step day-by-day so each BYDAY weekday within the week is
+				// considered; jumping a fixed 7 days would stay locked to
+				// DTSTART's own weekday and never reach the others.
+				return v.start.AddDate(0, 0, i), true
+			}
+			return v.start.AddDate(0, 0, 7*i), true
+		case "MONTHLY":
+			if len(byDay) > 0 {
+				return v.start.AddDate(0, 0, i), true
+			}
+			return addMonthsClamped(v.start, i), true
+		case "YEARLY":
+			if len(byDay) > 0 {
+				return v.start.AddDate(0, 0, i), true
+			}
+			return addYearsClamped(v.start, i), true
+		default:
+			return v.start, i == 0 // unknown FREQ: only DTSTART itself
+		}
+	}
+
+	var events []Event
+	occurrences := 0
+	for i := 0; ; i++ {
+		t, ok := occurrenceAt(i)
+		if !ok || !t.Before(yearEnd) {
+			break
+		}
+		if hasUntil && t.After(until) {
+			break
+		}
+		if len(byDay) > 0 && !weekdayIn(t.Weekday(), byDay) {
+			continue
+		}
+		occurrences++
+		if hasCount && occurrences > count {
+			break
+		}
+		if !t.Before(yearStart) {
+			events = append(events, Event{
+				Start:    t,
+				End:      t.Add(dur),
+				Summary:  v.summary,
+				Location: v.location,
+			})
+		}
+	}
+	return events
+}
+
+// addMonthsClamped adds n months to t, clamping the day-of-month to the
+// target month's last day instead of overflowing into the month after (the
+// behavior of time.Time.AddDate).
+func addMonthsClamped(t time.Time, n int) time.Time {
+	firstOfTarget := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).AddDate(0, n, 0)
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfTarget.Year(), firstOfTarget.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// addYearsClamped adds n years to t, clamping Feb 29 to Feb 28 in
+// non-leap target years instead of overflowing into March.
+func addYearsClamped(t time.Time, n int) time.Time {
+	return addMonthsClamped(t, 12*n)
+}
+
+func weekdayIn(wd time.Weekday, set []time.Weekday) bool {
+	for _, w := range set {
+		if w == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// eventsOnDay returns the events (already sorted by start time) that start
+// on the given day, for use on day/week pages.
+func eventsOnDay(events []Event, d time.Time) []Event {
+	var out []Event
+	for _, e := range events {
+		if e.Start.Year() == d.Year() && e.Start.Month() == d.Month() && e.Start.Day() == d.Day() {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// formatEventLine renders an event as a bullet line, e.g.
+// "• 09:00–10:00 Standup".
+func formatEventLine(e Event) string {
+	if e.Summary == "" {
+		e.Summary = "(untitled)"
+	}
+	return fmt.Sprintf("• %s–%s %s", e.Start.Format("15:04"), e.End.Format("15:04"), e.Summary)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParseICSTestTime(s string) time.Time {
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// TestExpandEvent covers the RRULE expansion cases that bit us in review:
+// DAILY/WEEKLY+BYDAY stepping, MONTHLY day-of-month clamping across short
+// months, and UNTIL/COUNT bounding.
+func TestExpandEvent(t *testing.T) {
+	cases := []struct {
+		name      string
+		v         vevent
+		year      int
+		wantDates []string // "2006-01-02", in order
+	}{
+		{
+			name: "daily with count",
+			v: vevent{
+				start: mustParseICSTestTime("2026-01-01T09:00:00"),
+				end:   mustParseICSTestTime("2026-01-01T09:30:00"),
+				rrule: map[string]string{"FREQ": "DAILY", "COUNT": "5"},
+			},
+			year:      2026,
+			wantDates: []string{"2026-01-01", "2026-01-02", "2026-01-03", "2026-01-04", "2026-01-05"},
+		},
+		{
+			name: "weekly byday visits every listed weekday",
+			v: vevent{
+				start: mustParseICSTestTime("2026-07-06T09:00:00"), // a Monday
+				end:   mustParseICSTestTime("2026-07-06T09:30:00"),
+				rrule: map[string]string{"FREQ": "WEEKLY", "BYDAY": "MO,WE,FR", "COUNT": "6"},
+			},
+			year:      2026,
+			wantDates: []string{"2026-07-06", "2026-07-08", "2026-07-10", "2026-07-13", "2026-07-15", "2026-07-17"},
+		},
+		{
+			name: "monthly clamps day of month instead of overflowing",
+			v: vevent{
+				start: mustParseICSTestTime("2026-01-31T09:00:00"),
+				end:   mustParseICSTestTime("2026-01-31T09:30:00"),
+				rrule: map[string]string{"FREQ": "MONTHLY", "COUNT": "6"},
+			},
+			year:      2026,
+			wantDates: []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31", "2026-06-30"},
+		},
+		{
+			name: "yearly restores feb 29 on the next leap year",
+			v: vevent{
+				start: mustParseICSTestTime("2024-02-29T09:00:00"),
+				end:   mustParseICSTestTime("2024-02-29T09:30:00"),
+				rrule: map[string]string{"FREQ": "YEARLY", "UNTIL": "20280301T000000"},
+			},
+			year:      2028,
+			wantDates: []string{"2028-02-29"},
+		},
+		{
+			name: "until stops expansion mid-year",
+			v: vevent{
+				start: mustParseICSTestTime("2026-03-01T09:00:00"),
+				end:   mustParseICSTestTime("2026-03-01T09:30:00"),
+				rrule: map[string]string{"FREQ": "DAILY", "UNTIL": "20260303T235900"},
+			},
+			year:      2026,
+			wantDates: []string{"2026-03-01", "2026-03-02", "2026-03-03"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			events := expandEvent(c.v, c.year)
+			if len(events) != len(c.wantDates) {
+				t.Fatalf("got %d events, want %d: %v", len(events), len(c.wantDates), events)
+			}
+			for i, want := range c.wantDates {
+				if got := events[i].Start.Format("2006-01-02"); got != want {
+					t.Errorf("event %d: got date %s, want %s", i, got, want)
+				}
+			}
+		})
+	}
+}
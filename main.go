@@ -3,22 +3,28 @@ package main
 import (
 	"flag"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/go-pdf/fpdf"
 )
 
 type Layout struct {
-	PageSize        string  // "Letter" or "PaperPro"
-	Margin          float64 // pt
-	Font            string
-	TitleSize       float64
-	SubTitleSize    float64
-	BodySize        float64
-	GridSpacingPt   float64
-	ShowWeeks       bool
-	ShowDays        bool
-	ShowCollections bool
+	PageSize             string  // any name in the presets registry; unknown names fall back to "Letter"
+	Margin               float64 // pt
+	Font                 string
+	TitleSize            float64
+	SubTitleSize         float64
+	BodySize             float64
+	GridSpacingPt        float64
+	ShowWeeks            bool
+	ShowDays             bool
+	ShowCollections      bool
+	Collections          []CollectionSpec
+	DayBackground        Background // background drawn behind day pages
+	CollectionBackground string     // default style name for collections that don't set their own
+	Outline              bool       // emit a PDF bookmarks tree mirroring the Links hierarchy
 }
 
 // Config passed into builders.
@@ -34,7 +40,7 @@ type Links struct {
 	Months      [12]int        // 1..12
 	Weeks       map[int][]int  // month -> per-week anchors
 	Days        map[string]int // "YYYY-MM-DD" -> anchor
-	Collections map[string]int // ["Writing","Ideas","OOO"] -> anchor
+	Collections map[string]int // collection name -> anchor
 	Hubs        map[string]int // e.g., "Collections" hub
 }
 
@@ -72,13 +78,59 @@ func monthWeeks(year int, month time.Month) [][]time.Time {
 	return weeks
 }
 
+/************************ Page presets *******************************/
+
+// PagePreset is a physical page size, in points, plus the device's native
+// DPI (kept for reference/conversion, not used directly in layout math).
+type PagePreset struct {
+	Wd, Ht float64
+	DPI    float64
+}
+
+// presets is the device/paper size registry consulted by Layout.PageSize.
+// Unrecognized names fall back to "Letter".
+var presets = map[string]PagePreset{
+	"Letter":       {Wd: 612, Ht: 792, DPI: 72},
+	"A4":           {Wd: 595.28, Ht: 841.89, DPI: 72},
+	"A5":           {Wd: 419.53, Ht: 595.28, DPI: 72},
+	"PaperPro":     {Wd: 504, Ht: 672, DPI: 300},
+	"reMarkable2":  {Wd: 416.5, Ht: 555, DPI: 226},
+	"SupernoteA5X": {Wd: 417.6, Ht: 583.2, DPI: 226},
+	"BooxNoteAir":  {Wd: 444.9, Ht: 592.6, DPI: 300},
+	"KindleScribe": {Wd: 446.4, Ht: 595.4, DPI: 300},
+}
+
+// RegisterPreset adds or overrides a named page size so it can be selected
+// with -page.
+func RegisterPreset(name string, p PagePreset) {
+	presets[name] = p
+}
+
+func pagePreset(layout Layout) PagePreset {
+	if p, ok := presets[layout.PageSize]; ok {
+		return p
+	}
+	return presets["Letter"]
+}
+
 /************************ Drawing helpers ***************************/
+
+// ensurePageSize stamps the current page with CropBox/TrimBox entries
+// matching the active PagePreset, so e-ink readers crop cleanly to the
+// drawable area instead of padding to a generic default.
 func ensurePageSize(pdf *fpdf.Fpdf, layout Layout) {
-	if layout.PageSize == "PaperPro" {
-		// reMarkable Paper Pro is 2100x2800 px; assuming ~300dpi that’s ~7x9.333in.
-		// Points: 72 pt/in → ~504 x ~672. Use a custom size roughly matching the ratio.
-		pdf.SetAutoPageBreak(true, layout.Margin)
-	}
+	preset := pagePreset(layout)
+	pdf.SetPageBox("crop", 0, 0, preset.Wd, preset.Ht)
+	pdf.SetPageBox("trim", 0, 0, preset.Wd, preset.Ht)
+}
+
+// startPage adds a page sized per cfg.Layout.PageSize, applies margins, and
+// stamps its CropBox/TrimBox. Every page builder starts a page through this
+// instead of calling AddPage directly.
+func startPage(pdf *fpdf.Fpdf, cfg PlannerConfig) {
+	pdf.AddPage()
+	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
+	ensurePageSize(pdf, cfg.Layout)
 }
 
 func dotGrid(pdf *fpdf.Fpdf, l Layout) {
@@ -94,6 +146,101 @@ func dotGrid(pdf *fpdf.Fpdf, l Layout) {
 	}
 }
 
+// Background draws a page's writable surface within its margins. Selected
+// per page type (Layout.DayBackground) or per collection
+// (CollectionSpec.Style / Layout.CollectionBackground).
+type Background interface {
+	Draw(pdf *fpdf.Fpdf, l Layout)
+}
+
+// DotGrid is the classic bullet-journal dot grid.
+type DotGrid struct{}
+
+func (DotGrid) Draw(pdf *fpdf.Fpdf, l Layout) { dotGrid(pdf, l) }
+
+// Lined draws horizontal ruled lines spaced by GridSpacingPt.
+type Lined struct{}
+
+func (Lined) Draw(pdf *fpdf.Fpdf, l Layout) { linedBackground(pdf, l) }
+
+// Squared draws a full horizontal/vertical ruled grid (graph paper).
+type Squared struct{}
+
+func (Squared) Draw(pdf *fpdf.Fpdf, l Layout) { squaredBackground(pdf, l) }
+
+// Isometric draws dots on a 30° triangular lattice: rows offset by
+// spacing/2 and spaced vertically by spacing*sqrt(3)/2.
+type Isometric struct{}
+
+func (Isometric) Draw(pdf *fpdf.Fpdf, l Layout) {
+	w, h := pdf.GetPageSize()
+	left, top, right, bottom := l.Margin, l.Margin, w-l.Margin, h-l.Margin
+	r := 0.6
+	vSpacing := l.GridSpacingPt * math.Sqrt(3) / 2
+	pdf.SetLineWidth(0.1)
+	pdf.SetDrawColor(0, 0, 0)
+	row := 0
+	for y := top + vSpacing; y < bottom; y += vSpacing {
+		xOffset := 0.0
+		if row%2 == 1 {
+			xOffset = l.GridSpacingPt / 2
+		}
+		for x := left + l.GridSpacingPt + xOffset; x < right; x += l.GridSpacingPt {
+			pdf.Circle(x, y, r, "F")
+		}
+		row++
+	}
+}
+
+// Cornell draws a two-column note-taking layout: a vertical cue-column rule
+// at ~30% of the page width and a horizontal summary rule ~20% up from the
+// bottom, with a dot grid filling the main notes region.
+type Cornell struct{}
+
+func (Cornell) Draw(pdf *fpdf.Fpdf, l Layout) {
+	w, h := pdf.GetPageSize()
+	left, top, right, bottom := l.Margin, l.Margin, w-l.Margin, h-l.Margin
+	cueX := left + (right-left)*0.3
+	summaryY := bottom - (bottom-top)*0.2
+
+	pdf.SetLineWidth(0.6)
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.Line(cueX, top, cueX, summaryY)
+	pdf.Line(left, summaryY, right, summaryY)
+
+	r := 0.6
+	pdf.SetLineWidth(0.1)
+	for y := top + l.GridSpacingPt; y < summaryY; y += l.GridSpacingPt {
+		for x := cueX + l.GridSpacingPt; x < right; x += l.GridSpacingPt {
+			pdf.Circle(x, y, r, "F")
+		}
+	}
+}
+
+// Blank leaves the page surface untouched.
+type Blank struct{}
+
+func (Blank) Draw(pdf *fpdf.Fpdf, l Layout) {}
+
+var backgroundsByName = map[string]Background{
+	"dotgrid":   DotGrid{},
+	"lined":     Lined{},
+	"squared":   Squared{},
+	"grid":      Squared{}, // alias used by CollectionSpec.Style
+	"isometric": Isometric{},
+	"cornell":   Cornell{},
+	"blank":     Blank{},
+}
+
+// backgroundByName looks up a Background by its -bg/collection style name,
+// defaulting to DotGrid for an unrecognized name.
+func backgroundByName(name string) Background {
+	if bg, ok := backgroundsByName[name]; ok {
+		return bg
+	}
+	return DotGrid{}
+}
+
 func setTitle(pdf *fpdf.Fpdf, l Layout, text string) {
 	pdf.SetFont(l.Font, "B", l.TitleSize)
 	pdf.CellFormat(0, 0, text, "", 1, "CM", false, 0, "")
@@ -161,10 +308,11 @@ func gridLinks(pdf *fpdf.Fpdf, l Layout, cols int, labels []string, anchors []in
 
 /************************ Page builders *****************************/
 func yearPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links) {
-	pdf.AddPage()
-	ensurePageSize(pdf, cfg.Layout)
-	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
+	startPage(pdf, cfg)
 	pdf.SetLink(links.Year, 0, pdf.PageNo())
+	if cfg.Layout.Outline {
+		pdf.Bookmark(fmt.Sprintf("%d", cfg.Year), 0, -1)
+	}
 
 	setTitle(pdf, cfg.Layout, fmt.Sprintf("%d Bullet Journal", cfg.Year))
 	setSubTitle(pdf, cfg.Layout, "Months")
@@ -176,65 +324,91 @@ func yearPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links) {
 	}
 	gridLinks(pdf, cfg.Layout, 3, labels, anchors)
 
-	if cfg.Layout.ShowCollections {
+	if cfg.Layout.ShowCollections && len(cfg.Layout.Collections) > 0 {
 		setSubTitle(pdf, cfg.Layout, "Collections")
-		names := []string{"Writing", "Ideas", "OOO"}
-		anchors := []int{
-			links.Collections["Writing"],
-			links.Collections["Ideas"],
-			links.Collections["OOO"],
+		names := make([]string, len(cfg.Layout.Collections))
+		anchors := make([]int, len(cfg.Layout.Collections))
+		for i, c := range cfg.Layout.Collections {
+			names[i] = c.Name
+			anchors[i] = links.Collections[c.Name]
 		}
 		gridLinks(pdf, cfg.Layout, 3, names, anchors)
 	}
+
+	if links.Hubs["Timeline"] != 0 {
+		setSubTitle(pdf, cfg.Layout, "Timeline")
+		gridLinks(pdf, cfg.Layout, 1, []string{"Timeline"}, []int{links.Hubs["Timeline"]})
+	}
 }
 
 func monthPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, month int) {
-	pdf.AddPage()
-	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
+	startPage(pdf, cfg)
 	pdf.SetLink(links.Months[month-1], 0, pdf.PageNo())
+	if cfg.Layout.Outline {
+		pdf.Bookmark(time.Month(month).String(), 1, -1)
+	}
 
 	addNav(pdf, cfg.Layout, links.Year, 0)
 	setTitle(pdf, cfg.Layout, fmt.Sprintf("%s %d", time.Month(month), cfg.Year))
 
 	weeks := monthWeeks(cfg.Year, time.Month(month))
-	pdf.SetFont(cfg.Layout.Font, "", cfg.Layout.BodySize)
-	startY := pdf.GetY()
+	drawMonthGrid(pdf, cfg, links, month, weeks)
+}
 
-	weekColWidth := 300.0
-	dayColWidth := 60.0
+// drawMonthGrid renders the classic 7-column × N-row month spread: one column
+// per weekday (Monday-first, matching monthWeeks), one row per week. Cells
+// outside the target month are greyed out. In-month cells link to the
+// corresponding day page, and today's cell gets a highlight fill.
+func drawMonthGrid(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, month int, weeks [][]time.Time) {
+	l := cfg.Layout
+	w, h := pdf.GetPageSize()
+	left, top := l.Margin, pdf.GetY()
+	right, bottom := w-l.Margin, h-l.Margin
 
-	// List weeks on the left
-	for idx, wk := range weeks {
-		mon, sun := wk[0], wk[6]
-		lbl := fmt.Sprintf("Week %d  (%s – %s)", idx+1, mon.Format("Jan 02"), sun.Format("Jan 02"))
-		y := pdf.GetY()
-		pdf.CellFormat(weekColWidth, 14, lbl, "", 1, "L", false, 0, "")
-		if cfg.Layout.ShowWeeks {
-			pdf.Link(cfg.Layout.Margin, y, weekColWidth, 14, links.Weeks[month][idx])
-		}
-	}
+	cols, rows := 7, len(weeks)
+	cellW := (right - left) / float64(cols)
+	cellH := (bottom - top) / float64(rows)
 
-	// Column of days on the right
-	daysInMonth := time.Date(cfg.Year, time.Month(month+1), 0, 0, 0, 0, 0, time.UTC).Day()
-	dayX := cfg.Layout.Margin + weekColWidth + 10
-	pdf.SetXY(dayX, startY)
-	for d := 1; d <= daysInMonth; d++ {
-		y := pdf.GetY()
-		label := fmt.Sprintf("%2d", d)
-		pdf.CellFormat(dayColWidth, 14, label, "", 0, "L", false, 0, "")
-		if cfg.Layout.ShowDays {
-			dt := time.Date(cfg.Year, time.Month(month), d, 0, 0, 0, 0, time.UTC)
-			key := dt.Format("2006-01-02")
-			pdf.Link(dayX, y, dayColWidth, 14, links.Days[key])
+	today := time.Now()
+	highlightToday := cfg.Year == today.Year()
+
+	pdf.SetFont(l.Font, "", l.BodySize)
+	for row, wk := range weeks {
+		for col, d := range wk {
+			x := left + float64(col)*cellW
+			y := top + float64(row)*cellH
+			inMonth := int(d.Month()) == month
+
+			if highlightToday && inMonth && d.Year() == today.Year() && d.Month() == today.Month() && d.Day() == today.Day() {
+				pdf.SetFillColor(220, 230, 255)
+				pdf.Rect(x, y, cellW, 16, "F")
+			}
+
+			pdf.Rect(x, y, cellW, cellH, "D")
+			if inMonth {
+				pdf.SetTextColor(0, 0, 0)
+			} else {
+				pdf.SetTextColor(170, 170, 170)
+			}
+			pdf.SetXY(x+4, y+2)
+			pdf.CellFormat(cellW-8, 14, fmt.Sprintf("%d", d.Day()), "", 0, "L", false, 0, "")
+
+			if l.ShowDays && inMonth {
+				key := d.Format("2006-01-02")
+				pdf.Link(x, y, cellW, cellH, links.Days[key])
+			}
 		}
-		pdf.SetXY(dayX, y+14)
 	}
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetY(bottom)
 }
 
-func weekPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, month int, weekIdx int, days []time.Time) {
-	pdf.AddPage()
-	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
+func weekPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, month int, weekIdx int, days []time.Time, events []Event) {
+	startPage(pdf, cfg)
 	pdf.SetLink(links.Weeks[month][weekIdx], 0, pdf.PageNo())
+	if cfg.Layout.Outline {
+		pdf.Bookmark(fmt.Sprintf("Week %d", weekIdx+1), 2, -1)
+	}
 
 	addNav(pdf, cfg.Layout, links.Year, links.Months[month-1])
 	setTitle(pdf, cfg.Layout, fmt.Sprintf("%s – Week %d", time.Month(month), weekIdx+1))
@@ -253,65 +427,275 @@ func weekPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, month int, weekId
 			key := d.Format("2006-01-02")
 			pdf.Link(cfg.Layout.Margin, y, 300, 14, links.Days[key])
 		}
+		if int(d.Month()) == month {
+			drawEventList(pdf, cfg.Layout, eventsOnDay(events, d))
+		}
 	}
 	pdf.SetTextColor(0, 0, 0)
 }
 
-func dayPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, d time.Time, back int) {
-	pdf.AddPage()
-	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
+func dayPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, d time.Time, back int, events []Event) {
+	startPage(pdf, cfg)
 	key := d.Format("2006-01-02")
 	pdf.SetLink(links.Days[key], 0, pdf.PageNo())
+	if cfg.Layout.Outline {
+		pdf.Bookmark(d.Format("Mon, Jan 02"), 3, -1)
+	}
 
 	addNav(pdf, cfg.Layout, links.Year, back)
 	setTitle(pdf, cfg.Layout, d.Format("Mon, Jan 02, 2006"))
-	dotGrid(pdf, cfg.Layout)
+	drawEventList(pdf, cfg.Layout, eventsOnDay(events, d))
+
+	bg := cfg.Layout.DayBackground
+	if bg == nil {
+		bg = DotGrid{}
+	}
+	bg.Draw(pdf, cfg.Layout)
+}
+
+// drawEventList renders a small bullet list of events ahead of the page's
+// main grid, e.g. "• 09:00–10:00 Standup".
+func drawEventList(pdf *fpdf.Fpdf, l Layout, evs []Event) {
+	if len(evs) == 0 {
+		return
+	}
+	pdf.SetFont(l.Font, "", l.BodySize)
+	for _, e := range evs {
+		pdf.CellFormat(0, 14, formatEventLine(e), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
 }
 
 func collectionsHub(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links) {
-	pdf.AddPage()
-	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
+	startPage(pdf, cfg)
 	pdf.SetLink(links.Hubs["Collections"], 0, pdf.PageNo())
+	if cfg.Layout.Outline {
+		pdf.Bookmark("Collections", 0, -1)
+	}
 
 	addNav(pdf, cfg.Layout, links.Year, 0)
 	setTitle(pdf, cfg.Layout, "Collections")
 
-	names := []string{"Writing", "Ideas", "OOO"}
-	anchors := []int{
-		links.Collections["Writing"],
-		links.Collections["Ideas"],
-		links.Collections["OOO"],
+	names := make([]string, len(cfg.Layout.Collections))
+	anchors := make([]int, len(cfg.Layout.Collections))
+	for i, c := range cfg.Layout.Collections {
+		names[i] = c.Name
+		anchors[i] = links.Collections[c.Name]
 	}
 	gridLinks(pdf, cfg.Layout, 3, names, anchors)
 }
 
-func collectionPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, name string) {
-	pdf.AddPage()
-	pdf.SetMargins(cfg.Layout.Margin, cfg.Layout.Margin, cfg.Layout.Margin)
-	pdf.SetLink(links.Collections[name], 0, pdf.PageNo())
+func collectionPage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, spec CollectionSpec) {
+	startPage(pdf, cfg)
+	pdf.SetLink(links.Collections[spec.Name], 0, pdf.PageNo())
+	if cfg.Layout.Outline {
+		pdf.Bookmark(spec.Name, 1, -1)
+	}
 
 	addNav(pdf, cfg.Layout, links.Year, links.Hubs["Collections"])
-	setTitle(pdf, cfg.Layout, name)
-	dotGrid(pdf, cfg.Layout)
+	setTitle(pdf, cfg.Layout, spec.Name)
+	drawCollectionBackground(pdf, cfg, spec.Style)
+
+	for extra := 1; extra < spec.Pages; extra++ {
+		startPage(pdf, cfg)
+		addNav(pdf, cfg.Layout, links.Year, links.Hubs["Collections"])
+		setTitle(pdf, cfg.Layout, spec.Name)
+		drawCollectionBackground(pdf, cfg, spec.Style)
+	}
 }
 
-/************************ Build *****************************/
-func buildPlanner(cfg PlannerConfig) error {
-	// Page size selection
-	var pdf *fpdf.Fpdf
-	switch cfg.Layout.PageSize {
-	case "PaperPro":
-		// Custom ~7x9.333 in (≈504 x 672 pt). The device scales, but this preserves aspect.
-		pdf = fpdf.NewCustom(&fpdf.InitType{
-			OrientationStr: "P",
-			UnitStr:        "pt",
-			Size: fpdf.SizeType{
-				Wd: 504, Ht: 672,
-			},
-		})
-	default:
-		pdf = fpdf.New("P", "pt", "Letter", "")
+// drawCollectionBackground renders the page background for a given
+// CollectionSpec.Style, falling back to Layout.CollectionBackground when the
+// collection doesn't set its own. "checklist" (a collection-only style, not
+// part of the Background interface) is handled directly; everything else
+// goes through backgroundByName.
+func drawCollectionBackground(pdf *fpdf.Fpdf, cfg PlannerConfig, style string) {
+	if style == "" {
+		style = cfg.Layout.CollectionBackground
+	}
+	if style == "checklist" {
+		checklistBackground(pdf, cfg.Layout)
+		return
+	}
+	backgroundByName(style).Draw(pdf, cfg.Layout)
+}
+
+func linedBackground(pdf *fpdf.Fpdf, l Layout) {
+	w, h := pdf.GetPageSize()
+	left, right := l.Margin, w-l.Margin
+	top, bottom := l.Margin, h-l.Margin
+	pdf.SetLineWidth(0.4)
+	pdf.SetDrawColor(200, 200, 200)
+	for y := top + l.GridSpacingPt; y < bottom; y += l.GridSpacingPt {
+		pdf.Line(left, y, right, y)
+	}
+}
+
+func squaredBackground(pdf *fpdf.Fpdf, l Layout) {
+	w, h := pdf.GetPageSize()
+	left, right := l.Margin, w-l.Margin
+	top, bottom := l.Margin, h-l.Margin
+	pdf.SetLineWidth(0.2)
+	pdf.SetDrawColor(210, 210, 210)
+	for y := top + l.GridSpacingPt; y < bottom; y += l.GridSpacingPt {
+		pdf.Line(left, y, right, y)
+	}
+	for x := left + l.GridSpacingPt; x < right; x += l.GridSpacingPt {
+		pdf.Line(x, top, x, bottom)
+	}
+}
+
+func checklistBackground(pdf *fpdf.Fpdf, l Layout) {
+	w, h := pdf.GetPageSize()
+	left, right := l.Margin, w-l.Margin
+	top, bottom := l.Margin, h-l.Margin
+	box := 10.0
+	pdf.SetLineWidth(0.6)
+	pdf.SetDrawColor(0, 0, 0)
+	for y := top + l.GridSpacingPt; y < bottom; y += l.GridSpacingPt {
+		pdf.Rect(left, y-box, box, box, "D")
+		pdf.Line(left+box+6, y, right, y)
+	}
+}
+
+// timelinePage renders a horizontal Gantt-style chart across the year: a
+// tiered month/week/day header followed by one colored bar per task,
+// positioned by date-to-x mapping. Each bar links to the corresponding day
+// page when one exists.
+func timelinePage(pdf *fpdf.Fpdf, cfg PlannerConfig, links *Links, tasks []Task) {
+	startPage(pdf, cfg)
+	pdf.SetLink(links.Hubs["Timeline"], 0, pdf.PageNo())
+
+	addNav(pdf, cfg.Layout, links.Year, 0)
+	setTitle(pdf, cfg.Layout, fmt.Sprintf("%d Timeline", cfg.Year))
+
+	l := cfg.Layout
+	w, h := pdf.GetPageSize()
+	left, right := l.Margin, w-l.Margin
+	bottom := h - l.Margin
+
+	spanStart := time.Date(cfg.Year, 1, 1, 0, 0, 0, 0, time.UTC)
+	spanEnd := time.Date(cfg.Year, 12, 31, 0, 0, 0, 0, time.UTC)
+	spanDays := spanEnd.Sub(spanStart).Hours()/24 + 1
+
+	xForDate := func(t time.Time) float64 {
+		offset := t.Sub(spanStart).Hours() / 24
+		return left + offset/spanDays*(right-left)
+	}
+
+	rowH := 18.0
+	headerY := pdf.GetY()
+	headerH := drawTimelineHeader(pdf, l, spanStart, spanEnd, xForDate, headerY)
+	y := headerY + headerH + 8
+
+	pdf.SetFont(l.Font, "", l.BodySize)
+	for _, t := range tasks {
+		start, err1 := time.Parse("2006-01-02", t.Start)
+		end, err2 := time.Parse("2006-01-02", t.End)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		// Paginate rows explicitly: Rect doesn't participate in fpdf's
+		// auto-page-break the way Cell/CellFormat does, so letting the two
+		// drift across an implicit page break desyncs bars from labels.
+		if y+rowH > bottom {
+			startPage(pdf, cfg)
+			addNav(pdf, cfg.Layout, links.Year, 0)
+			setTitle(pdf, cfg.Layout, fmt.Sprintf("%d Timeline (cont.)", cfg.Year))
+			headerY = pdf.GetY()
+			headerH = drawTimelineHeader(pdf, l, spanStart, spanEnd, xForDate, headerY)
+			y = headerY + headerH + 8
+			pdf.SetFont(l.Font, "", l.BodySize)
+		}
+
+		x0, x1 := xForDate(start), xForDate(end)
+		if x1 < x0 {
+			x0, x1 = x1, x0
+		}
+
+		r, g, b := parseHexColor(t.Color)
+		pdf.SetFillColor(r, g, b)
+		pdf.Rect(x0, y, x1-x0, rowH-4, "F")
+
+		pdf.SetTextColor(0, 0, 0)
+		pdf.SetXY(left, y-2)
+		pdf.CellFormat(0, 14, t.Name, "", 0, "L", false, 0, "")
+
+		if cfg.Layout.ShowDays && start.Year() == cfg.Year {
+			if anchor := links.Days[start.Format("2006-01-02")]; anchor != 0 {
+				pdf.Link(x0, y, x1-x0, rowH-4, anchor)
+			}
+		}
+		y += rowH
+	}
+}
+
+// drawTimelineHeader draws the tiered header row used by the timeline page:
+// months are always shown, weeks are added when the span is short enough to
+// keep the labels legible, and days are added when it's shorter still. It
+// returns the total height consumed.
+func drawTimelineHeader(pdf *fpdf.Fpdf, l Layout, start, end time.Time, xForDate func(time.Time) float64, y float64) float64 {
+	pdf.SetFont(l.Font, "B", l.BodySize)
+	spanDays := end.Sub(start).Hours() / 24
+
+	for m := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location()); !m.After(end); m = m.AddDate(0, 1, 0) {
+		nextMonth := m.AddDate(0, 1, 0)
+		x0, x1 := xForDate(m), xForDate(nextMonth)
+		pdf.SetXY(x0, y)
+		pdf.CellFormat(x1-x0, 14, m.Format("Jan"), "LTB", 0, "C", false, 0, "")
+	}
+	height := 14.0
+
+	if spanDays <= 120 {
+		weekY := y + height
+		for w := start.AddDate(0, 0, -int(start.Weekday())); !w.After(end); w = w.AddDate(0, 0, 7) {
+			x0, x1 := xForDate(w), xForDate(w.AddDate(0, 0, 7))
+			_, isoWeek := w.ISOWeek()
+			pdf.SetXY(x0, weekY)
+			pdf.CellFormat(x1-x0, 12, fmt.Sprintf("%d", isoWeek), "LTB", 0, "C", false, 0, "")
+		}
+		height += 12
+	}
+
+	if spanDays <= 31 {
+		dayY := y + height
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			x0, x1 := xForDate(d), xForDate(d.AddDate(0, 0, 1))
+			pdf.SetXY(x0, dayY)
+			pdf.CellFormat(x1-x0, 10, fmt.Sprintf("%d", d.Day()), "LTB", 0, "C", false, 0, "")
+		}
+		height += 10
+	}
+
+	return height
+}
+
+// parseHexColor parses a "#RRGGBB" string into RGB components, falling back
+// to a muted blue when the value is missing or malformed.
+func parseHexColor(s string) (int, int, int) {
+	s = strings.TrimPrefix(s, "#")
+	var r, g, b int
+	if len(s) != 6 {
+		return 120, 170, 220
 	}
+	if _, err := fmt.Sscanf(s, "%02x%02x%02x", &r, &g, &b); err != nil {
+		return 120, 170, 220
+	}
+	return r, g, b
+}
+
+/************************ Build *****************************/
+func buildPlanner(cfg PlannerConfig, events []Event, tasks []Task) error {
+	// Page size selection, from the PagePreset registry.
+	preset := pagePreset(cfg.Layout)
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "pt",
+		Size: fpdf.SizeType{
+			Wd: preset.Wd, Ht: preset.Ht,
+		},
+	})
 
 	pdf.SetTitle(fmt.Sprintf("Bullet Journal %d", cfg.Year), false)
 	pdf.SetAuthor("Planner Generator (Go)", false)
@@ -338,29 +722,36 @@ func buildPlanner(cfg PlannerConfig) error {
 			}
 		}
 	}
-	if cfg.Layout.ShowCollections {
+	if cfg.Layout.ShowCollections && len(cfg.Layout.Collections) > 0 {
 		links.Hubs["Collections"] = pdf.AddLink()
-		links.Collections["Writing"] = pdf.AddLink()
-		links.Collections["Ideas"] = pdf.AddLink()
-		links.Collections["OOO"] = pdf.AddLink()
+		for _, c := range cfg.Layout.Collections {
+			links.Collections[c.Name] = pdf.AddLink()
+		}
+	}
+	if len(tasks) > 0 {
+		links.Hubs["Timeline"] = pdf.AddLink()
 	}
 
 	// Year hub
 	yearPage(pdf, cfg, links)
 
+	if len(tasks) > 0 {
+		timelinePage(pdf, cfg, links, tasks)
+	}
+
 	// Months → Weeks → Days
 	for month := 1; month <= 12; month++ {
 		monthPage(pdf, cfg, links, month)
 		if cfg.Layout.ShowWeeks {
 			weeks := monthWeeks(cfg.Year, time.Month(month))
 			for idx, wk := range weeks {
-				weekPage(pdf, cfg, links, month, idx, wk)
+				weekPage(pdf, cfg, links, month, idx, wk, events)
 				if cfg.Layout.ShowDays {
 					for _, d := range wk {
 						if int(d.Month()) != month {
 							continue
 						}
-						dayPage(pdf, cfg, links, d, links.Weeks[month][idx])
+						dayPage(pdf, cfg, links, d, links.Weeks[month][idx], events)
 					}
 				}
 			}
@@ -368,16 +759,24 @@ func buildPlanner(cfg PlannerConfig) error {
 	}
 
 	// Collections
-	if cfg.Layout.ShowCollections {
+	if cfg.Layout.ShowCollections && len(cfg.Layout.Collections) > 0 {
 		collectionsHub(pdf, cfg, links)
-		for _, name := range []string{"Writing", "Ideas", "OOO"} {
-			collectionPage(pdf, cfg, links, name)
+		for _, c := range cfg.Layout.Collections {
+			collectionPage(pdf, cfg, links, c)
 		}
 	}
 
 	return pdf.OutputFileAndClose(cfg.Output)
 }
 
+// defaultCollections reproduces the planner's original built-in trio, used
+// when no -config file is given.
+var defaultCollections = []CollectionSpec{
+	{Name: "Writing", Pages: 1, Style: "dotgrid"},
+	{Name: "Ideas", Pages: 1, Style: "dotgrid"},
+	{Name: "OOO", Pages: 1, Style: "dotgrid"},
+}
+
 /**************************** CLI ***************************/
 func main() {
 	var year int
@@ -385,25 +784,64 @@ func main() {
 	var full bool
 	var page string
 	var grid float64
+	var ics string
+	var tasksPath string
+	var configPath string
+	var bg string
 
 	flag.IntVar(&year, "year", time.Now().Year(), "calendar year to generate")
 	flag.StringVar(&out, "out", "journal.pdf", "output PDF filename")
 	flag.BoolVar(&full, "full", false, "include Weeks and Days (bigger PDF)")
-	flag.StringVar(&page, "page", "Letter", "page size: Letter | PaperPro")
+	flag.StringVar(&page, "page", "Letter", "page size: any name registered in the PagePreset registry (Letter, A4, A5, PaperPro, reMarkable2, SupernoteA5X, BooxNoteAir, KindleScribe, or a custom RegisterPreset)")
 	flag.Float64Var(&grid, "grid", 22, "dot-grid spacing in points")
+	flag.StringVar(&ics, "ics", "", "path to an .ics file whose events are overlaid on day/week pages")
+	flag.StringVar(&tasksPath, "tasks", "", "path to a YAML/JSON tasks file rendered as a Timeline collection page")
+	flag.StringVar(&configPath, "config", "", "path to a planner.yaml declaring custom Collections")
+	flag.StringVar(&bg, "bg", "dotgrid", "default page background: dotgrid | lined | squared | isometric | cornell | blank")
 	flag.Parse()
 
+	collections := defaultCollections
+	if configPath != "" {
+		c, err := LoadCollections(configPath)
+		if err != nil {
+			panic(err)
+		}
+		collections = c
+	}
+
+	var events []Event
+	if ics != "" {
+		var err error
+		events, err = LoadICS(ics, year)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	var tasks []Task
+	if tasksPath != "" {
+		var err error
+		tasks, err = LoadTasks(tasksPath)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	layout := Layout{
-		PageSize:        page,
-		Margin:          36, // 0.5"
-		Font:            "Helvetica",
-		TitleSize:       24,
-		SubTitleSize:    14,
-		BodySize:        12,
-		GridSpacingPt:   grid,
-		ShowWeeks:       full,
-		ShowDays:        full,
-		ShowCollections: true,
+		PageSize:             page,
+		Margin:               36, // 0.5"
+		Font:                 "Helvetica",
+		TitleSize:            24,
+		SubTitleSize:         14,
+		BodySize:             12,
+		GridSpacingPt:        grid,
+		ShowWeeks:            full,
+		ShowDays:             full,
+		ShowCollections:      true,
+		Collections:          collections,
+		DayBackground:        backgroundByName(bg),
+		CollectionBackground: bg,
+		Outline:              true,
 	}
 
 	cfg := PlannerConfig{
@@ -411,7 +849,7 @@ func main() {
 		Output: out,
 		Layout: layout,
 	}
-	if err := buildPlanner(cfg); err != nil {
+	if err := buildPlanner(cfg, events, tasks); err != nil {
 		panic(err)
 	}
 	fmt.Printf("Wrote %s for %d\n", out, year)
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Task is one row on the Timeline collection page: a single Gantt-style bar.
+type Task struct {
+	Name  string `json:"name" yaml:"name"`
+	Start string `json:"start" yaml:"start"` // "2006-01-02"
+	End   string `json:"end" yaml:"end"`     // "2006-01-02"
+	Color string `json:"color" yaml:"color"` // "#RRGGBB"
+}
+
+// LoadTasks reads a YAML or JSON tasks file (format chosen by extension)
+// into a slice of Task.
+func LoadTasks(path string) ([]Task, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []Task
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tasks)
+	default:
+		err = json.Unmarshal(data, &tasks)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tasks: %w", err)
+	}
+	return tasks, nil
+}